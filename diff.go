@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// errChangesDetected signals that runDiff found a difference; main uses
+// it to exit non-zero without treating the diff itself as a failure.
+var errChangesDetected = errors.New("changes detected")
+
+// FieldChange records that a function's Field differs between two
+// snapshots.
+type FieldChange struct {
+	Name  string
+	Field string
+	Old   string
+	New   string
+}
+
+// FunctionDiff is the result of comparing two FunctionDoc snapshots.
+type FunctionDiff struct {
+	Added   []FunctionDoc
+	Removed []FunctionDoc
+	Changed []FieldChange
+}
+
+// Empty reports whether the snapshots were identical.
+func (d FunctionDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String renders d as a human-readable report, one line per addition,
+// removal, or changed field.
+func (d FunctionDiff) String() string {
+	var b strings.Builder
+	for _, fn := range d.Added {
+		fmt.Fprintf(&b, "+ %s\n", fn.Name)
+	}
+	for _, fn := range d.Removed {
+		fmt.Fprintf(&b, "- %s\n", fn.Name)
+	}
+	for _, c := range d.Changed {
+		fmt.Fprintf(&b, "~ %s: %s changed from %q to %q\n", c.Name, c.Field, c.Old, c.New)
+	}
+	return b.String()
+}
+
+// diffFunctions compares prev against curr by function name, reporting
+// functions added, removed, or whose Type, Default, or Availability
+// changed.
+func diffFunctions(prev, curr []FunctionDoc) FunctionDiff {
+	prevByName := make(map[string]FunctionDoc, len(prev))
+	for _, fn := range prev {
+		prevByName[fn.Name] = fn
+	}
+	currByName := make(map[string]FunctionDoc, len(curr))
+	for _, fn := range curr {
+		currByName[fn.Name] = fn
+	}
+
+	var d FunctionDiff
+	for _, fn := range curr {
+		old, ok := prevByName[fn.Name]
+		if !ok {
+			d.Added = append(d.Added, fn)
+			continue
+		}
+		d.Changed = append(d.Changed, fieldChanges(old, fn)...)
+	}
+	for _, fn := range prev {
+		if _, ok := currByName[fn.Name]; !ok {
+			d.Removed = append(d.Removed, fn)
+		}
+	}
+	return d
+}
+
+func fieldChanges(old, updated FunctionDoc) []FieldChange {
+	var changes []FieldChange
+	if old.Type != updated.Type {
+		changes = append(changes, FieldChange{Name: updated.Name, Field: "Type", Old: old.Type, New: updated.Type})
+	}
+	if old.Default != updated.Default {
+		changes = append(changes, FieldChange{Name: updated.Name, Field: "Default", Old: old.Default, New: updated.Default})
+	}
+	if old.Availability != updated.Availability {
+		changes = append(changes, FieldChange{Name: updated.Name, Field: "Availability", Old: old.Availability, New: updated.Availability})
+	}
+	return changes
+}
+
+// runDiff implements `diff -snapshot <file> [flags]`: it scrapes the
+// current functions table and reports how it differs from a previous
+// `generate json` snapshot, returning errChangesDetected when it does so
+// CI can fail the build and surface the change as a reviewable PR.
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	snapshot := fs.String("snapshot", "", "path to a previous `generate json` snapshot to diff against")
+	input := fs.String("input", "", "path to a saved functions.html snapshot, used instead of -url")
+	url := fs.String("url", defaultFunctionsURL, "URL of the functions table to scrape, instead of every default reference page")
+	cache := fs.String("cache", "", "directory to cache the last successful -url fetch in")
+	params := fs.Bool("params", false, "also fetch each function's per-function parameter table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *snapshot == "" {
+		return fmt.Errorf("diff requires -snapshot")
+	}
+
+	prevData, err := os.ReadFile(*snapshot)
+	if err != nil {
+		return fmt.Errorf("could not read snapshot: %w", err)
+	}
+	var prev []FunctionDoc
+	if err := json.Unmarshal(prevData, &prev); err != nil {
+		return fmt.Errorf("could not parse snapshot: %w", err)
+	}
+
+	curr, err := collectDocs(singleSourceOverride(fs, input, url, cache), *params)
+	if err != nil {
+		return err
+	}
+
+	diff := diffFunctions(prev, curr)
+	fmt.Print(diff.String())
+	if !diff.Empty() {
+		return errChangesDetected
+	}
+	return nil
+}
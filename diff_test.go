@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+func TestDiffFunctions(t *testing.T) {
+	prev := []FunctionDoc{
+		{Name: "round", Type: "Numeric", Availability: "All"},
+		{Name: "removed", Type: "Numeric", Availability: "All"},
+	}
+	curr := []FunctionDoc{
+		{Name: "round", Type: "Numeric", Availability: "LogScale Cloud"},
+		{Name: "added", Type: "String", Availability: "All"},
+	}
+
+	diff := diffFunctions(prev, curr)
+
+	if len(diff.Added) != 1 || diff.Added[0].Name != "added" {
+		t.Errorf("Added = %+v, want [added]", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Name != "removed" {
+		t.Errorf("Removed = %+v, want [removed]", diff.Removed)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0] != (FieldChange{Name: "round", Field: "Availability", Old: "All", New: "LogScale Cloud"}) {
+		t.Errorf("Changed = %+v, want a single Availability change for round", diff.Changed)
+	}
+	if diff.Empty() {
+		t.Error("Empty() = true, want false")
+	}
+}
+
+func TestDiffFunctionsEmpty(t *testing.T) {
+	docs := []FunctionDoc{{Name: "round", Type: "Numeric"}}
+	if diff := diffFunctions(docs, docs); !diff.Empty() {
+		t.Errorf("diffFunctions(docs, docs) = %+v, want empty", diff)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// renderElisp renders docs as a `defconst logscale-functions` form: an
+// alist keyed by bare function name, whose value is a plist carrying
+// everything logscale-mode needs for company/corfu completion, eldoc
+// signature hints, and describe-function-style help buffers.
+func renderElisp(docs []FunctionDoc) (string, error) {
+	var b strings.Builder
+	b.WriteString(";; Auto-generated by main.go. Do not edit by hand.\n")
+	b.WriteString("(defconst logscale-functions\n  '(")
+	for i, fn := range docs {
+		if i > 0 {
+			b.WriteString("\n    ")
+		}
+		b.WriteString("(")
+		b.WriteString(elispString(fn.Name))
+		b.WriteString(" . (:category ")
+		b.WriteString(elispString(fn.Category))
+		b.WriteString(" :type ")
+		b.WriteString(elispString(fn.Type))
+		b.WriteString(" :default-args ")
+		b.WriteString(elispString(fn.Default))
+		b.WriteString(" :availability ")
+		b.WriteString(elispString(fn.Availability))
+		b.WriteString(" :description ")
+		b.WriteString(elispString(fn.Description))
+		b.WriteString(" :signature ")
+		b.WriteString(elispString(fn.Signature))
+		b.WriteString(" :parameters ")
+		b.WriteString(elispParameters(fn.Parameters))
+		b.WriteString("))")
+	}
+	b.WriteString(")\n  \"Alist of LogScale function name to its metadata plist.\")\n")
+	return b.String(), nil
+}
+
+// elispString renders s as a double-quoted elisp string literal, escaping
+// backslashes and double quotes.
+func elispString(s string) string {
+	return strconv.Quote(s)
+}
+
+// elispParameters renders params as a list of plists, one per argument,
+// for eldoc to walk when a function's coarse :default-args cell isn't
+// enough to describe its signature.
+func elispParameters(params []Parameter) string {
+	var b strings.Builder
+	b.WriteString("(")
+	for i, p := range params {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString("(:name ")
+		b.WriteString(elispString(p.Name))
+		b.WriteString(" :type ")
+		b.WriteString(elispString(p.Type))
+		b.WriteString(" :required ")
+		b.WriteString(elispString(p.Required))
+		b.WriteString(" :default ")
+		b.WriteString(elispString(p.Default))
+		b.WriteString(")")
+	}
+	b.WriteString(")")
+	return b.String()
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestElispString(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"plain", `"plain"`},
+		{`has "quotes"`, `"has \"quotes\""`},
+		{`back\slash`, `"back\\slash"`},
+	}
+	for _, c := range cases {
+		if got := elispString(c.in); got != c.want {
+			t.Errorf("elispString(%q) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+const defaultFunctionsURL = "https://library.humio.com/data-analysis/functions.html"
+
+// fetchOptions controls where the raw functions.html table is read from.
+type fetchOptions struct {
+	// input, if non-empty, is a path to a checked-in HTML snapshot and
+	// takes precedence over url.
+	input string
+	// url is fetched over the network when input is empty.
+	url string
+	// cache, if non-empty, is a directory that the last successful
+	// network fetch is written to, keyed by the source URL's base name.
+	cache string
+}
+
+// fetchHTML returns the raw HTML of the functions table according to
+// opts: from opts.input if set, otherwise from opts.url, caching the
+// result under opts.cache when the fetch came from the network.
+func fetchHTML(opts fetchOptions) (string, error) {
+	if opts.input != "" {
+		data, err := os.ReadFile(opts.input)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+
+	resp, err := http.Get(opts.url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	html := string(body)
+
+	if opts.cache != "" && resp.StatusCode == http.StatusOK {
+		if err := os.MkdirAll(opts.cache, 0o755); err != nil {
+			return "", err
+		}
+		cachePath := filepath.Join(opts.cache, cacheFileName(opts.url))
+		if err := os.WriteFile(cachePath, body, 0o644); err != nil {
+			return "", err
+		}
+	}
+
+	return html, nil
+}
+
+// cacheFileName derives the cache file name for rawURL from its path's
+// base name, so caching a run against a different source page (the
+// aggregate or query page, say) doesn't silently overwrite or mislabel
+// another page's cached snapshot under a fixed "functions.html" name.
+func cacheFileName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "download.html"
+	}
+	base := path.Base(u.Path)
+	if base == "" || base == "." || base == "/" {
+		return "download.html"
+	}
+	return base
+}
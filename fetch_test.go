@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+func TestCacheFileName(t *testing.T) {
+	cases := []struct {
+		url  string
+		want string
+	}{
+		{"https://library.humio.com/data-analysis/functions.html", "functions.html"},
+		{"https://library.humio.com/data-analysis/functions-aggregate.html", "functions-aggregate.html"},
+		{"https://library.humio.com/data-analysis/functions-query.html", "functions-query.html"},
+		{"https://library.humio.com", "download.html"},
+		{"https://library.humio.com/", "download.html"},
+	}
+	for _, c := range cases {
+		if got := cacheFileName(c.url); got != c.want {
+			t.Errorf("cacheFileName(%q) = %q, want %q", c.url, got, c.want)
+		}
+	}
+}
@@ -1,47 +1,81 @@
 package main
 
-import (
-	"context"
-	"fmt"
-	"os"
-	"strings"
-
-	// https://stackoverflow.com/a/74328802
-	"github.com/nfx/go-htmltable"
-)
+import "strings"
 
 type Function struct {
-	Function string `header:"Function"`
-	Type string `header:"Type"`
-	Default string `header:"Default Argument"`
+	Function     string `header:"Function"`
+	Type         string `header:"Type"`
+	Default      string `header:"Default Argument"`
 	Availability string `header:"Availability"`
-	Description string `header:"Description"`
+	Description  string `header:"Description"`
+}
+
+// Parameter is a single row of a function's per-function parameter
+// table, giving eldoc/company the type and requiredness that the coarse
+// "Default Argument" cell on the index page doesn't carry.
+type Parameter struct {
+	Name     string `header:"Name"`
+	Type     string `header:"Type"`
+	Required string `header:"Required"`
+	Default  string `header:"Default"`
+}
+
+// Signature is the parsed form of a Function's raw "Function" cell, e.g.
+// "round(value, [decimals])" splits into Name "round" and Params
+// "(value, [decimals])".
+type Signature struct {
+	Name   string
+	Params string
 }
 
-func extractFunc(funcRaw string) string {
-	// Find the index of the first parenthesis
+// parseSignature splits a raw Function cell into its bare name and its
+// parameter list (including the surrounding parentheses). If funcRaw has
+// no parameter list, Params is empty.
+func parseSignature(funcRaw string) Signature {
 	startIndex := strings.Index(funcRaw, "(")
 	if startIndex == -1 {
-		// Return the original string if there's no parenthesis
-		return funcRaw
+		return Signature{Name: funcRaw}
+	}
+	return Signature{
+		Name:   strings.TrimSpace(funcRaw[:startIndex]),
+		Params: strings.TrimSpace(funcRaw[startIndex:]),
 	}
-	// Extract the substring up to the first parenthesis
-	return funcRaw[:startIndex]
 }
 
-func main() {
-	htmltable.Logger = func(_ context.Context, msg string, fields ...any) {
-		fmt.Printf("[INFO] %s %v\n", msg, fields)
-	}
+// FunctionDoc is the renderer-facing view of a Function: its name and
+// signature already split out, tagged with the reference page it came
+// from, and ready for any output backend to consume without re-parsing
+// the raw scraped cell.
+type FunctionDoc struct {
+	Name         string      `json:"name"`
+	Signature    string      `json:"signature"`
+	Category     string      `json:"category"`
+	Type         string      `json:"type"`
+	Default      string      `json:"default_argument"`
+	Availability string      `json:"availability"`
+	Description  string      `json:"description"`
+	Parameters   []Parameter `json:"parameters,omitempty"`
+}
 
-	url := "https://library.humio.com/data-analysis/functions.html"
-	table, err := htmltable.NewSliceFromURL[Function](url)
-	if err != nil {
-		fmt.Printf("[ERROR] Could not get table by %s: %s", url, err)
-		os.Exit(1)
+func newFunctionDoc(fn Function, category string) FunctionDoc {
+	sig := parseSignature(fn.Function)
+	return FunctionDoc{
+		Name:         sig.Name,
+		Signature:    sig.Name + sig.Params,
+		Category:     category,
+		Type:         fn.Type,
+		Default:      fn.Default,
+		Availability: fn.Availability,
+		Description:  fn.Description,
 	}
+}
 
-	for i := 0; i < len(table); i++ {
-		fmt.Printf("\"%s\" ", extractFunc(table[i].Function))
+// buildDocs converts a scraped table into the renderer-facing FunctionDoc
+// form shared by every output backend, tagging every row with category.
+func buildDocs(table []Function, category string) []FunctionDoc {
+	docs := make([]FunctionDoc, len(table))
+	for i, fn := range table {
+		docs[i] = newFunctionDoc(fn, category)
 	}
+	return docs
 }
@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestParseSignature(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want Signature
+	}{
+		{"with params", "round(value, [decimals])", Signature{Name: "round", Params: "(value, [decimals])"}},
+		{"no params", "now", Signature{Name: "now"}},
+		{"empty parens", "pi()", Signature{Name: "pi", Params: "()"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := parseSignature(c.in)
+			if got != c.want {
+				t.Errorf("parseSignature(%q) = %+v, want %+v", c.in, got, c.want)
+			}
+		})
+	}
+}
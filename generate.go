@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// renderers maps a `generate` subcommand format to the backend that
+// renders a scraped table into that format. Adding a new output is a
+// matter of writing a new renderer and registering it here.
+var renderers = map[string]func([]FunctionDoc) (string, error){
+	"elisp":    renderElisp,
+	"json":     renderJSON,
+	"markdown": renderMarkdown,
+}
+
+// runGenerate implements `generate <format> [flags]`: it scrapes the
+// functions table(s) once and hands the shared FunctionDoc slice to
+// whichever renderer matches format.
+func runGenerate(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("generate requires a format: elisp, json, or markdown")
+	}
+	format := args[0]
+	render, ok := renderers[format]
+	if !ok {
+		return fmt.Errorf("unknown format %q (want elisp, json, or markdown)", format)
+	}
+
+	fs := flag.NewFlagSet("generate "+format, flag.ExitOnError)
+	input := fs.String("input", "", "path to a saved functions.html snapshot, used instead of -url")
+	url := fs.String("url", defaultFunctionsURL, "URL of the functions table to scrape, instead of every default reference page")
+	cache := fs.String("cache", "", "directory to cache the last successful -url fetch in")
+	params := fs.Bool("params", false, "also fetch each function's per-function parameter table")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	docs, err := collectDocs(singleSourceOverride(fs, input, url, cache), *params)
+	if err != nil {
+		return err
+	}
+
+	out, err := render(docs)
+	if err != nil {
+		return err
+	}
+	fmt.Print(out)
+	return nil
+}
+
+// singleSourceOverride returns a fetchOptions built from -input/-url/
+// -cache when the caller explicitly set -input or -url, or nil when
+// neither was set, so the caller falls back to scraping defaultSources.
+func singleSourceOverride(fs *flag.FlagSet, input, url, cache *string) *fetchOptions {
+	var explicit bool
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "input" || f.Name == "url" {
+			explicit = true
+		}
+	})
+	if !explicit {
+		return nil
+	}
+	return &fetchOptions{input: *input, url: *url, cache: *cache}
+}
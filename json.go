@@ -0,0 +1,13 @@
+package main
+
+import "encoding/json"
+
+// renderJSON renders docs as an indented JSON array, for LSP glue or
+// editors other than Emacs.
+func renderJSON(docs []FunctionDoc) (string, error) {
+	b, err := json.MarshalIndent(docs, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b) + "\n", nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	// https://stackoverflow.com/a/74328802
+	"github.com/nfx/go-htmltable"
+)
+
+func main() {
+	htmltable.Logger = func(_ context.Context, msg string, fields ...any) {
+		fmt.Printf("[INFO] %s %v\n", msg, fields)
+	}
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "generate":
+		err = runGenerate(os.Args[2:])
+	case "diff":
+		err = runDiff(os.Args[2:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+	if errors.Is(err, errChangesDetected) {
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Printf("[ERROR] %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: logscale-funcs generate <elisp|json|markdown> [flags]")
+	fmt.Fprintln(os.Stderr, "       logscale-funcs diff -snapshot <file> [flags]")
+}
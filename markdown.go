@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderMarkdown renders docs as a Markdown reference table mirroring the
+// columns of the Function struct, suitable for checking into the repo as
+// human-readable documentation.
+func renderMarkdown(docs []FunctionDoc) (string, error) {
+	var b strings.Builder
+	b.WriteString("| Function | Signature | Category | Type | Default Argument | Availability | Description |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, fn := range docs {
+		fmt.Fprintf(&b, "| %s | `%s` | %s | %s | %s | %s | %s |\n",
+			escapeMarkdownCell(fn.Name),
+			escapeMarkdownCell(fn.Signature),
+			escapeMarkdownCell(fn.Category),
+			escapeMarkdownCell(fn.Type),
+			escapeMarkdownCell(fn.Default),
+			escapeMarkdownCell(fn.Availability),
+			escapeMarkdownCell(fn.Description),
+		)
+	}
+	return b.String(), nil
+}
+
+// escapeMarkdownCell escapes pipe characters so they aren't read as
+// Markdown column separators. Newlines are left as they are.
+func escapeMarkdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
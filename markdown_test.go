@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestEscapeMarkdownCell(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"plain", "plain"},
+		{"a | b", "a \\| b"},
+		{"a || b", "a \\|\\| b"},
+	}
+	for _, c := range cases {
+		if got := escapeMarkdownCell(c.in); got != c.want {
+			t.Errorf("escapeMarkdownCell(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nfx/go-htmltable"
+)
+
+// source pairs a LogScale reference page with the category its matched
+// table should be tagged with.
+type source struct {
+	Category string
+	URL      string
+}
+
+// defaultSources lists every reference page merged into a full
+// `generate`/`diff` run when neither -input nor -url overrides it with a
+// single page.
+var defaultSources = []source{
+	{Category: "Function", URL: defaultFunctionsURL},
+	{Category: "Aggregate Function", URL: "https://library.humio.com/data-analysis/functions-aggregate.html"},
+	{Category: "Query Function", URL: "https://library.humio.com/data-analysis/functions-query.html"},
+}
+
+// paramsURLTemplate is the per-function parameter page for a function
+// named by its lower-cased, path-escaped bare name.
+const paramsURLTemplate = "https://library.humio.com/data-analysis/functions/%s.html"
+
+// fetchSource scrapes a single reference page and tags every row with
+// src.Category.
+func fetchSource(src source) ([]FunctionDoc, error) {
+	page, err := htmltable.NewFromURL(src.URL)
+	if err != nil {
+		return nil, fmt.Errorf("could not load %s: %w", src.URL, err)
+	}
+	docs, err := parseSourcePage(page, src.Category)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse functions table on %s: %w", src.URL, err)
+	}
+	return docs, nil
+}
+
+// parseSourcePage extracts a reference page's functions table and tags
+// every row with category. NewSliceFromPage locates the table by the
+// Function struct's own header tags, the same way NewSliceFromURL does,
+// so unrelated tables on the same page are skipped without us having to
+// name the header set ourselves.
+func parseSourcePage(page *htmltable.Page, category string) ([]FunctionDoc, error) {
+	table, err := htmltable.NewSliceFromPage[Function](page)
+	if err != nil {
+		return nil, err
+	}
+	return buildDocs(table, category), nil
+}
+
+// fetchParameters scrapes the per-function parameter table for the
+// function named name, returning its fully-typed argument metadata. name
+// is path-escaped before being slotted into the URL, since LogScale
+// function names aren't guaranteed to be path-safe as-is.
+func fetchParameters(name string) ([]Parameter, error) {
+	slug := url.PathEscape(strings.ToLower(name))
+	paramsURL := fmt.Sprintf(paramsURLTemplate, slug)
+	params, err := htmltable.NewSliceFromURL[Parameter](paramsURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not load parameters for %s: %w", name, err)
+	}
+	return params, nil
+}
+
+// collectDocs scrapes and merges every FunctionDoc a `generate`/`diff`
+// run needs. When override is non-nil it is used as the single source
+// instead of defaultSources, preserving the -input/-url reproducible
+// single-snapshot workflow. When withParams is set, each function's
+// per-function parameter table is additionally fetched and attached.
+func collectDocs(override *fetchOptions, withParams bool) ([]FunctionDoc, error) {
+	var docs []FunctionDoc
+	if override != nil {
+		html, err := fetchHTML(*override)
+		if err != nil {
+			return nil, fmt.Errorf("could not get functions table: %w", err)
+		}
+		table, err := htmltable.NewSliceFromString[Function](html)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse functions table: %w", err)
+		}
+		docs = buildDocs(table, "Function")
+	} else {
+		for _, src := range defaultSources {
+			d, err := fetchSource(src)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, d...)
+		}
+	}
+
+	if withParams {
+		for i := range docs {
+			params, err := fetchParameters(docs[i].Name)
+			if err != nil {
+				fmt.Printf("[WARN] %s\n", err)
+				continue
+			}
+			docs[i].Parameters = params
+		}
+	}
+
+	return docs, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nfx/go-htmltable"
+)
+
+// fixtureFunctionsTable renders a minimal reference page with the same
+// header shape as functions.html, functions-aggregate.html, and
+// functions-query.html, so parseSourcePage can be exercised without a
+// network call.
+const fixtureFunctionsTable = `
+<table>
+<tr><th>Function</th><th>Type</th><th>Default Argument</th><th>Availability</th><th>Description</th></tr>
+<tr><td>%s</td><td>Numeric</td><td>value</td><td>All</td><td>%s</td></tr>
+</table>
+`
+
+func TestParseSourcePage(t *testing.T) {
+	cases := []struct {
+		name     string
+		funcCell string
+		category string
+	}{
+		{"aggregate page", "sum(value)", "Aggregate Function"},
+		{"query page", "groupBy(field)", "Query Function"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			html := fmt.Sprintf(fixtureFunctionsTable, c.funcCell, c.name)
+			page, err := htmltable.NewFromString(html)
+			if err != nil {
+				t.Fatalf("NewFromString: %s", err)
+			}
+			docs, err := parseSourcePage(page, c.category)
+			if err != nil {
+				t.Fatalf("parseSourcePage: %s", err)
+			}
+			if len(docs) != 1 {
+				t.Fatalf("got %d docs, want 1", len(docs))
+			}
+			if docs[0].Category != c.category {
+				t.Errorf("Category = %q, want %q", docs[0].Category, c.category)
+			}
+			if docs[0].Description != c.name {
+				t.Errorf("Description = %q, want %q", docs[0].Description, c.name)
+			}
+		})
+	}
+}